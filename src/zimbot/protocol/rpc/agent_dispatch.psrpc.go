@@ -28,10 +28,47 @@ type AgentDispatchInternalClient[RoomTopicType ~string] interface {
 
 	ListDispatch(ctx context.Context, room RoomTopicType, req *livekit3.ListAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.ListAgentDispatchResponse, error)
 
+	// WatchDispatch streams AgentDispatch create/update/delete events for a
+	// room, starting from req.StartRevision when set. The returned
+	// DispatchWatcher must be closed by the caller once it is no longer
+	// needed.
+	WatchDispatch(ctx context.Context, room RoomTopicType, req *livekit3.WatchAgentDispatchRequest, opts ...psrpc.RequestOption) (DispatchWatcher, error)
+
+	// GetDispatch fetches the current state of a single dispatch.
+	GetDispatch(ctx context.Context, room RoomTopicType, req *livekit3.GetAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.AgentDispatch, error)
+
+	// PauseDispatch quiesces every agent worker running the dispatch
+	// without deleting it, e.g. for cost control or migration.
+	PauseDispatch(ctx context.Context, room RoomTopicType, req *livekit3.PauseAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.AgentDispatch, error)
+
+	// ResumeDispatch undoes a prior PauseDispatch.
+	ResumeDispatch(ctx context.Context, room RoomTopicType, req *livekit3.ResumeAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.AgentDispatch, error)
+
+	// SignalDispatch delivers an out-of-band signal to the agent worker
+	// that owns the dispatch.
+	SignalDispatch(ctx context.Context, room RoomTopicType, req *livekit3.SignalAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.AgentDispatch, error)
+
 	// Close immediately, without waiting for pending RPCs
 	Close()
 }
 
+// DispatchWatcher is the client-side handle returned by WatchDispatch. It
+// surfaces the event stream as an idiomatic Go channel and lets the caller
+// resume a dropped subscription from the last observed revision.
+type DispatchWatcher interface {
+	// Events yields dispatch events in revision order. The channel is
+	// closed once the watch is stopped or the underlying stream ends.
+	Events() <-chan *livekit3.DispatchEvent
+
+	// Ack reports the last revision the caller has durably processed, so a
+	// subsequent WatchDispatch call with StartRevision set to it resumes
+	// without replaying already-handled events.
+	Ack(ctx context.Context, revision int64) error
+
+	// Close stops the watch and releases the underlying stream.
+	Close()
+}
+
 // ==========================================
 // AgentDispatchInternal ServerImpl Interface
 // ==========================================
@@ -42,6 +79,33 @@ type AgentDispatchInternalServerImpl interface {
 	DeleteDispatch(context.Context, *livekit3.DeleteAgentDispatchRequest) (*livekit3.AgentDispatch, error)
 
 	ListDispatch(context.Context, *livekit3.ListAgentDispatchRequest) (*livekit3.ListAgentDispatchResponse, error)
+
+	// WatchDispatch pushes a DispatchEvent to stream for every create,
+	// update, or delete of a livekit3.AgentDispatch in the room, resuming
+	// from req.StartRevision when it is non-zero.
+	WatchDispatch(context.Context, *livekit3.WatchAgentDispatchRequest, server.MultiResponseSink[*livekit3.DispatchEvent]) error
+
+	// AckWatchDispatch records the revision a watcher has durably
+	// processed, so a subsequent WatchDispatch call with StartRevision set
+	// to it can resume without replaying events the caller already saw.
+	AckWatchDispatch(context.Context, *livekit3.WatchAgentDispatchAck) (*livekit3.WatchAgentDispatchAck, error)
+
+	GetDispatch(context.Context, *livekit3.GetAgentDispatchRequest) (*livekit3.AgentDispatch, error)
+
+	PauseDispatch(context.Context, *livekit3.PauseAgentDispatchRequest) (*livekit3.AgentDispatch, error)
+
+	ResumeDispatch(context.Context, *livekit3.ResumeAgentDispatchRequest) (*livekit3.AgentDispatch, error)
+
+	// SignalDispatch is routed to the single agent worker that owns the
+	// dispatch, unlike PauseDispatch/ResumeDispatch, which are single
+	// requests that any one worker subscribed to the room topic may answer.
+	SignalDispatch(context.Context, *livekit3.SignalAgentDispatchRequest) (*livekit3.AgentDispatch, error)
+
+	// SignalDispatchAffinity scores this worker's claim to handle req, so
+	// RegisterSignalDispatchTopic's affinity func can route the call to the
+	// one worker that owns req.DispatchId instead of picking arbitrarily
+	// among every worker subscribed to the room topic. Return 0 to decline.
+	SignalDispatchAffinity(context.Context, *livekit3.SignalAgentDispatchRequest) float32
 }
 
 // ======================================
@@ -55,6 +119,18 @@ type AgentDispatchInternalServer[RoomTopicType ~string] interface {
 	DeregisterDeleteDispatchTopic(room RoomTopicType)
 	RegisterListDispatchTopic(room RoomTopicType) error
 	DeregisterListDispatchTopic(room RoomTopicType)
+	RegisterWatchDispatchTopic(room RoomTopicType) error
+	DeregisterWatchDispatchTopic(room RoomTopicType)
+	RegisterAckWatchDispatchTopic(room RoomTopicType) error
+	DeregisterAckWatchDispatchTopic(room RoomTopicType)
+	RegisterGetDispatchTopic(room RoomTopicType) error
+	DeregisterGetDispatchTopic(room RoomTopicType)
+	RegisterPauseDispatchTopic(room RoomTopicType) error
+	DeregisterPauseDispatchTopic(room RoomTopicType)
+	RegisterResumeDispatchTopic(room RoomTopicType) error
+	DeregisterResumeDispatchTopic(room RoomTopicType)
+	RegisterSignalDispatchTopic(room RoomTopicType) error
+	DeregisterSignalDispatchTopic(room RoomTopicType)
 	RegisterAllRoomTopics(room RoomTopicType) error
 	DeregisterAllRoomTopics(room RoomTopicType)
 
@@ -80,9 +156,24 @@ func NewAgentDispatchInternalClient[RoomTopicType ~string](bus psrpc.MessageBus,
 		ID:   rand.NewClientID(),
 	}
 
-	sd.RegisterMethod("CreateDispatch", false, false, true, true)
-	sd.RegisterMethod("DeleteDispatch", false, false, true, true)
-	sd.RegisterMethod("ListDispatch", false, false, true, true)
+	// requireClaim: true on every method. Access tokens are checked only by
+	// a server built with WithClaimVerifier; a client attaches one with
+	// WithClientAuth.
+	sd.RegisterMethod("CreateDispatch", true, false, true, true)
+	sd.RegisterMethod("DeleteDispatch", true, false, true, true)
+	sd.RegisterMethod("ListDispatch", true, false, true, true)
+	// multi: true — WatchDispatch streams a DispatchEvent per room update
+	// rather than returning a single response.
+	sd.RegisterMethod("WatchDispatch", true, false, true, true, true)
+	sd.RegisterMethod("AckWatchDispatch", true, false, true, true)
+	sd.RegisterMethod("GetDispatch", true, false, true, true)
+	sd.RegisterMethod("PauseDispatch", true, false, true, true)
+	sd.RegisterMethod("ResumeDispatch", true, false, true, true)
+	// affinity: true — SignalDispatch is routed by SignalDispatchAffinity
+	// to the single worker holding the dispatch, unlike the identically
+	// shaped PauseDispatch/ResumeDispatch/GetDispatch registrations above,
+	// which accept any responder.
+	sd.RegisterMethod("SignalDispatch", true, true, true, true)
 
 	rpcClient, err := client.NewRPCClient(sd, bus, opts...)
 	if err != nil {
@@ -106,10 +197,71 @@ func (c *agentDispatchInternalClient[RoomTopicType]) ListDispatch(ctx context.Co
 	return client.RequestSingle[*livekit3.ListAgentDispatchResponse](ctx, c.client, "ListDispatch", []string{string(room)}, req, opts...)
 }
 
+func (c *agentDispatchInternalClient[RoomTopicType]) WatchDispatch(ctx context.Context, room RoomTopicType, req *livekit3.WatchAgentDispatchRequest, opts ...psrpc.RequestOption) (DispatchWatcher, error) {
+	sub, err := client.RequestMulti[*livekit3.DispatchEvent](ctx, c.client, "WatchDispatch", []string{string(room)}, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &dispatchWatcher{
+		client: c.client,
+		room:   string(room),
+		sub:    sub,
+		events: make(chan *livekit3.DispatchEvent, sub.ChannelSize()),
+	}
+	go w.forward()
+	return w, nil
+}
+
+func (c *agentDispatchInternalClient[RoomTopicType]) GetDispatch(ctx context.Context, room RoomTopicType, req *livekit3.GetAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.AgentDispatch, error) {
+	return client.RequestSingle[*livekit3.AgentDispatch](ctx, c.client, "GetDispatch", []string{string(room)}, req, opts...)
+}
+
+func (c *agentDispatchInternalClient[RoomTopicType]) PauseDispatch(ctx context.Context, room RoomTopicType, req *livekit3.PauseAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.AgentDispatch, error) {
+	return client.RequestSingle[*livekit3.AgentDispatch](ctx, c.client, "PauseDispatch", []string{string(room)}, req, opts...)
+}
+
+func (c *agentDispatchInternalClient[RoomTopicType]) ResumeDispatch(ctx context.Context, room RoomTopicType, req *livekit3.ResumeAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.AgentDispatch, error) {
+	return client.RequestSingle[*livekit3.AgentDispatch](ctx, c.client, "ResumeDispatch", []string{string(room)}, req, opts...)
+}
+
+func (c *agentDispatchInternalClient[RoomTopicType]) SignalDispatch(ctx context.Context, room RoomTopicType, req *livekit3.SignalAgentDispatchRequest, opts ...psrpc.RequestOption) (*livekit3.AgentDispatch, error) {
+	return client.RequestSingle[*livekit3.AgentDispatch](ctx, c.client, "SignalDispatch", []string{string(room)}, req, opts...)
+}
+
 func (s *agentDispatchInternalClient[RoomTopicType]) Close() {
 	s.client.Close()
 }
 
+// dispatchWatcher adapts the raw psrpc multi-response subscription backing
+// WatchDispatch into the DispatchWatcher interface.
+type dispatchWatcher struct {
+	client *client.RPCClient
+	room   string
+	sub    psrpc.Subscription[*livekit3.DispatchEvent]
+	events chan *livekit3.DispatchEvent
+}
+
+func (w *dispatchWatcher) forward() {
+	defer close(w.events)
+	for ev := range w.sub.Channel() {
+		w.events <- ev
+	}
+}
+
+func (w *dispatchWatcher) Events() <-chan *livekit3.DispatchEvent {
+	return w.events
+}
+
+func (w *dispatchWatcher) Ack(ctx context.Context, revision int64) error {
+	_, err := client.RequestSingle[*livekit3.WatchAgentDispatchAck](ctx, w.client, "AckWatchDispatch", []string{w.room}, &livekit3.WatchAgentDispatchAck{Revision: revision})
+	return err
+}
+
+func (w *dispatchWatcher) Close() {
+	w.sub.Close()
+}
+
 // ============================
 // AgentDispatchInternal Server
 // ============================
@@ -129,9 +281,21 @@ func NewAgentDispatchInternalServer[RoomTopicType ~string](svc AgentDispatchInte
 
 	s := server.NewRPCServer(sd, bus, opts...)
 
-	sd.RegisterMethod("CreateDispatch", false, false, true, true)
-	sd.RegisterMethod("DeleteDispatch", false, false, true, true)
-	sd.RegisterMethod("ListDispatch", false, false, true, true)
+	sd.RegisterMethod("CreateDispatch", true, false, true, true)
+	sd.RegisterMethod("DeleteDispatch", true, false, true, true)
+	sd.RegisterMethod("ListDispatch", true, false, true, true)
+	// multi: true — WatchDispatch streams a DispatchEvent per room update
+	// rather than returning a single response.
+	sd.RegisterMethod("WatchDispatch", true, false, true, true, true)
+	sd.RegisterMethod("AckWatchDispatch", true, false, true, true)
+	sd.RegisterMethod("GetDispatch", true, false, true, true)
+	sd.RegisterMethod("PauseDispatch", true, false, true, true)
+	sd.RegisterMethod("ResumeDispatch", true, false, true, true)
+	// affinity: true — SignalDispatch is routed by SignalDispatchAffinity
+	// to the single worker holding the dispatch, unlike the identically
+	// shaped PauseDispatch/ResumeDispatch/GetDispatch registrations above,
+	// which accept any responder.
+	sd.RegisterMethod("SignalDispatch", true, true, true, true)
 	return &agentDispatchInternalServer[RoomTopicType]{
 		svc: svc,
 		rpc: s,
@@ -162,11 +326,67 @@ func (s *agentDispatchInternalServer[RoomTopicType]) DeregisterListDispatchTopic
 	s.rpc.DeregisterHandler("ListDispatch", []string{string(room)})
 }
 
+func (s *agentDispatchInternalServer[RoomTopicType]) RegisterWatchDispatchTopic(room RoomTopicType) error {
+	return server.RegisterMultiResponseHandler(s.rpc, "WatchDispatch", []string{string(room)}, s.svc.WatchDispatch, nil)
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) DeregisterWatchDispatchTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("WatchDispatch", []string{string(room)})
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) RegisterAckWatchDispatchTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "AckWatchDispatch", []string{string(room)}, s.svc.AckWatchDispatch, nil)
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) DeregisterAckWatchDispatchTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("AckWatchDispatch", []string{string(room)})
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) RegisterGetDispatchTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "GetDispatch", []string{string(room)}, s.svc.GetDispatch, nil)
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) DeregisterGetDispatchTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("GetDispatch", []string{string(room)})
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) RegisterPauseDispatchTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "PauseDispatch", []string{string(room)}, s.svc.PauseDispatch, nil)
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) DeregisterPauseDispatchTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("PauseDispatch", []string{string(room)})
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) RegisterResumeDispatchTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "ResumeDispatch", []string{string(room)}, s.svc.ResumeDispatch, nil)
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) DeregisterResumeDispatchTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("ResumeDispatch", []string{string(room)})
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) RegisterSignalDispatchTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "SignalDispatch", []string{string(room)}, s.svc.SignalDispatch, func(ctx context.Context, req *livekit3.SignalAgentDispatchRequest) float32 {
+		return s.svc.SignalDispatchAffinity(ctx, req)
+	})
+}
+
+func (s *agentDispatchInternalServer[RoomTopicType]) DeregisterSignalDispatchTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("SignalDispatch", []string{string(room)})
+}
+
 func (s *agentDispatchInternalServer[RoomTopicType]) allRoomTopicRegisterers() server.RegistererSlice {
 	return server.RegistererSlice{
 		server.NewRegisterer(s.RegisterCreateDispatchTopic, s.DeregisterCreateDispatchTopic),
 		server.NewRegisterer(s.RegisterDeleteDispatchTopic, s.DeregisterDeleteDispatchTopic),
 		server.NewRegisterer(s.RegisterListDispatchTopic, s.DeregisterListDispatchTopic),
+		server.NewRegisterer(s.RegisterWatchDispatchTopic, s.DeregisterWatchDispatchTopic),
+		server.NewRegisterer(s.RegisterAckWatchDispatchTopic, s.DeregisterAckWatchDispatchTopic),
+		server.NewRegisterer(s.RegisterGetDispatchTopic, s.DeregisterGetDispatchTopic),
+		server.NewRegisterer(s.RegisterPauseDispatchTopic, s.DeregisterPauseDispatchTopic),
+		server.NewRegisterer(s.RegisterResumeDispatchTopic, s.DeregisterResumeDispatchTopic),
+		server.NewRegisterer(s.RegisterSignalDispatchTopic, s.DeregisterSignalDispatchTopic),
 	}
 }
 
@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/psrpc"
+	"github.com/livekit/psrpc/pkg/server"
+)
+
+type accessTokenContextKey struct{}
+
+// WithAccessToken returns a context carrying tok. A client built with
+// WithClientAuth reads it back off the context of each call and attaches it
+// to the outgoing request, so callers don't have to thread a header through
+// every CreateDispatch/DeleteDispatch/... call by hand.
+func WithAccessToken(ctx context.Context, tok *auth.AccessToken) context.Context {
+	return context.WithValue(ctx, accessTokenContextKey{}, tok)
+}
+
+func accessTokenFromContext(ctx context.Context) (*auth.AccessToken, bool) {
+	tok, ok := ctx.Value(accessTokenContextKey{}).(*auth.AccessToken)
+	return tok, ok
+}
+
+// WithClientAuth returns a psrpc.ClientOption that signs the *auth.AccessToken
+// attached via WithAccessToken and injects it as a psrpc request header
+// (psrpc.AuthHeader) on every outgoing call. Use it alongside
+// NewAgentDispatchInternalClient when talking to a server configured with
+// WithClaimVerifier.
+func WithClientAuth() psrpc.ClientOption {
+	return psrpc.WithClientRPCInterceptors(func(info psrpc.RPCInfo, next psrpc.ClientRPCHandler) psrpc.ClientRPCHandler {
+		return func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			tok, ok := accessTokenFromContext(ctx)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			jwt, err := tok.ToJWT()
+			if err != nil {
+				return nil, err
+			}
+
+			return next(psrpc.ContextWithHeaders(ctx, map[string]string{
+				psrpc.AuthHeader: jwt,
+			}), req)
+		}
+	})
+}
+
+// TokenVerifier validates a signed LiveKit access token and returns the
+// grants it carries. *auth.VerifierFromKeyProvider and similar helpers used
+// by the public LiveKit API satisfy this interface.
+type TokenVerifier interface {
+	Verify(token string) (*auth.ClaimGrants, error)
+}
+
+// agentDispatchAttribute is the participant attribute a token issuer sets to
+// grant agent-dispatch access without handing out a full roomAdmin grant.
+// auth.VideoGrant has no dedicated field for this yet, so it rides on the
+// Attributes map every auth.ClaimGrants already carries rather than on a
+// grant field that doesn't exist in the vendored auth package.
+const agentDispatchAttribute = "lk.agentDispatch"
+
+// WithClaimVerifier returns a psrpc.ServerOption that authenticates every
+// call the server handles, whatever its RegisterMethod flags: it verifies
+// the token injected by WithClientAuth and rejects the call with
+// psrpc.Unauthenticated unless the caller holds a roomAdmin grant, or the
+// agentDispatchAttribute attribute, scoped to the room the call targets.
+// Without this option the server behaves as before — any caller with bus
+// access may dispatch agents into any room.
+func WithClaimVerifier(v TokenVerifier) psrpc.ServerOption {
+	return server.WithServerRPCInterceptors(func(info psrpc.RPCInfo, next psrpc.ServerRPCHandler) psrpc.ServerRPCHandler {
+		return func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			jwt, ok := psrpc.HeadersFromContext(ctx)[psrpc.AuthHeader]
+			if !ok || jwt == "" {
+				return nil, psrpc.NewErrorf(psrpc.Unauthenticated, "missing access token")
+			}
+
+			claims, err := v.Verify(jwt)
+			if err != nil {
+				return nil, psrpc.NewError(psrpc.Unauthenticated, err)
+			}
+
+			room := roomFromTopic(info.Topic)
+			if !authorizedForRoom(claims, room) {
+				return nil, psrpc.NewErrorf(psrpc.Unauthenticated, "missing roomAdmin grant or %s attribute scoped to room %q", agentDispatchAttribute, room)
+			}
+
+			return next(ctx, req)
+		}
+	})
+}
+
+// roomFromTopic pulls the room name back out of the topic parts psrpc
+// passes through RPCInfo — AgentDispatchInternal always registers its
+// handlers as []string{string(room)}.
+func roomFromTopic(topic []string) string {
+	if len(topic) == 0 {
+		return ""
+	}
+	return topic[0]
+}
+
+// authorizedForRoom reports whether claims grant access to room. The grant
+// must always be scoped to room: a token with
+// no Video grant, or one whose Room doesn't match, is rejected even if it
+// carries the agentDispatchAttribute — otherwise an attribute-only token
+// with no room scope at all would authorize its holder for every room on
+// the bus, defeating the per-room isolation this verifier exists to add.
+func authorizedForRoom(claims *auth.ClaimGrants, room string) bool {
+	if claims == nil || claims.Video == nil {
+		return false
+	}
+
+	grant := claims.Video
+	if grant.Room == "" || grant.Room != room {
+		return false
+	}
+
+	return grant.RoomAdmin || claims.Attributes[agentDispatchAttribute] == "true"
+}
@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/livekit/protocol/auth"
+)
+
+func TestAuthorizedForRoom(t *testing.T) {
+	const room = "room-1"
+
+	cases := []struct {
+		name   string
+		claims *auth.ClaimGrants
+		want   bool
+	}{
+		{
+			name:   "nil claims",
+			claims: nil,
+			want:   false,
+		},
+		{
+			name:   "no Video grant at all",
+			claims: &auth.ClaimGrants{Attributes: map[string]string{agentDispatchAttribute: "true"}},
+			want:   false,
+		},
+		{
+			name: "agentDispatch attribute but grant not scoped to any room",
+			claims: &auth.ClaimGrants{
+				Video:      &auth.VideoGrant{Room: ""},
+				Attributes: map[string]string{agentDispatchAttribute: "true"},
+			},
+			want: false,
+		},
+		{
+			name: "agentDispatch attribute but grant scoped to a different room",
+			claims: &auth.ClaimGrants{
+				Video:      &auth.VideoGrant{Room: "room-2"},
+				Attributes: map[string]string{agentDispatchAttribute: "true"},
+			},
+			want: false,
+		},
+		{
+			name: "agentDispatch attribute scoped to the right room",
+			claims: &auth.ClaimGrants{
+				Video:      &auth.VideoGrant{Room: room},
+				Attributes: map[string]string{agentDispatchAttribute: "true"},
+			},
+			want: true,
+		},
+		{
+			name: "roomAdmin scoped to the right room",
+			claims: &auth.ClaimGrants{
+				Video: &auth.VideoGrant{Room: room, RoomAdmin: true},
+			},
+			want: true,
+		},
+		{
+			name: "roomAdmin scoped to a different room",
+			claims: &auth.ClaimGrants{
+				Video: &auth.VideoGrant{Room: "room-2", RoomAdmin: true},
+			},
+			want: false,
+		},
+		{
+			name: "room-scoped grant with neither roomAdmin nor the attribute",
+			claims: &auth.ClaimGrants{
+				Video: &auth.VideoGrant{Room: room},
+			},
+			want: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := authorizedForRoom(c.claims, room); got != c.want {
+				t.Errorf("authorizedForRoom(...) = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestRoomFromTopic(t *testing.T) {
+	cases := []struct {
+		name  string
+		topic []string
+		want  string
+	}{
+		{"empty topic", nil, ""},
+		{"single part", []string{"room-1"}, "room-1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := roomFromTopic(c.topic); got != c.want {
+				t.Errorf("roomFromTopic(%v) = %q, want %q", c.topic, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+// Package mqtt provides a psrpc.MessageBus backed by an MQTT v5 broker, for
+// deployments that run AgentDispatchInternal (and other psrpc services) at
+// the edge alongside MQTT infrastructure instead of a NATS or Redis cluster.
+package mqtt
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/eclipse/paho.golang/paho"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/psrpc"
+)
+
+// requestQoS is used for the request queue so a briefly-disconnected agent
+// worker still receives calls like CreateDispatch once it reconnects with
+// the same client ID.
+const requestQoS = byte(1)
+
+// subscriptionBuffer bounds how many undelivered messages a subscription
+// holds before Deliver starts dropping newly arriving messages rather than
+// blocking the broker's read loop.
+const subscriptionBuffer = 128
+
+// ShareGroup names the MQTT $share group a queue subscription joins. Workers
+// that subscribe to the same channel with the same group compete for
+// messages, so each RequestSingle-style call goes to one worker of the pool
+// instead of fanning out to every subscriber.
+type ShareGroup string
+
+// Message is what a Subscription yields: the published payload plus the
+// MQTT5 response-topic/correlation-data properties Publish attaches to every
+// outgoing message, so a request handler can route its reply back to the
+// one caller awaiting it.
+type Message struct {
+	Payload       []byte
+	ResponseTopic string
+	CorrelationID string
+}
+
+// Bus is a psrpc.MessageBus implementation on top of an MQTT v5 client. Each
+// psrpc topic (the `[]string{string(room)}` parts generated bindings pass
+// to Publish/Subscribe) is used directly as the MQTT topic.
+type Bus struct {
+	client *paho.Client
+	group  ShareGroup
+
+	mu   sync.Mutex
+	subs map[string]*subscription
+}
+
+// Option configures a Bus.
+type Option func(*Bus)
+
+// WithShareGroup enables competing-consumer semantics: SubscribeQueue calls
+// join "$share/<group>/<topic>" instead of "<topic>", so multiple bus
+// instances subscribed with the same group split deliveries rather than each
+// getting a copy. Subscribe ignores the group and always uses the plain
+// topic, so fan-out (broadcast) subscriptions such as WatchDispatch still
+// get a copy each.
+func WithShareGroup(group ShareGroup) Option {
+	return func(b *Bus) {
+		b.group = group
+	}
+}
+
+// NewMessageBus wraps an already-connected MQTT v5 client (clean-start=false,
+// with a stable client ID) as a psrpc.MessageBus. The client's publish
+// handler must call Deliver for messages to reach subscriptions.
+func NewMessageBus(client *paho.Client, opts ...Option) psrpc.MessageBus {
+	b := &Bus{
+		client: client,
+		subs:   make(map[string]*subscription),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+type replyTo struct {
+	topic         string
+	correlationID string
+}
+
+type replyToContextKey struct{}
+
+// WithReplyTo overrides the response topic and correlation id Publish
+// attaches to every message published with ctx. Publish already generates
+// both by default (a reply-to topic derived from the request channel, and a
+// random correlation id), so most callers never need this — it exists for
+// handlers that must reply on a caller-chosen topic instead, mirroring an
+// MQTT5 native request/response exchange.
+func WithReplyTo(ctx context.Context, topic, correlationID string) context.Context {
+	return context.WithValue(ctx, replyToContextKey{}, replyTo{topic: topic, correlationID: correlationID})
+}
+
+func (b *Bus) Publish(ctx context.Context, channel string, msg proto.Message) error {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	rt, ok := ctx.Value(replyToContextKey{}).(replyTo)
+	if !ok {
+		rt = replyTo{topic: channel + "/reply", correlationID: uuid.NewString()}
+	}
+
+	_, err = b.client.Publish(ctx, &paho.Publish{
+		QoS:     requestQoS,
+		Topic:   channel,
+		Payload: payload,
+		Properties: &paho.PublishProperties{
+			ResponseTopic:   rt.topic,
+			CorrelationData: []byte(rt.correlationID),
+		},
+	})
+	return err
+}
+
+func (b *Bus) Subscribe(ctx context.Context, channel string) (psrpc.Subscription[*Message], error) {
+	return b.subscribe(ctx, channel, "")
+}
+
+// SubscribeQueue subscribes using the configured ShareGroup, if any, so that
+// only one of a pool of competing workers receives each message — the MQTT
+// analog of a NATS/Redis queue subscription used for RequestSingle.
+func (b *Bus) SubscribeQueue(ctx context.Context, channel string) (psrpc.Subscription[*Message], error) {
+	return b.subscribe(ctx, channel, b.group)
+}
+
+func (b *Bus) subscribe(ctx context.Context, channel string, group ShareGroup) (psrpc.Subscription[*Message], error) {
+	filter := subscriptionFilter(channel, group)
+
+	sub := newSubscription(channel)
+
+	b.mu.Lock()
+	b.subs[channel] = sub
+	b.mu.Unlock()
+
+	if _, err := b.client.Subscribe(ctx, &paho.Subscribe{
+		Subscriptions: []paho.SubscribeOptions{
+			{Topic: filter, QoS: requestQoS},
+		},
+	}); err != nil {
+		b.mu.Lock()
+		delete(b.subs, channel)
+		b.mu.Unlock()
+		sub.Close()
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// subscriptionFilter returns the MQTT subscription filter for channel, joining
+// the ShareGroup if one is set. The broker always delivers PUBLISH packets
+// tagged with the plain topic name — "$share/<group>/" is subscription-filter
+// syntax only and never appears on a delivered message — so Bus still
+// indexes subscriptions by the plain channel regardless of which filter form
+// was used to subscribe.
+func subscriptionFilter(channel string, group ShareGroup) string {
+	if group == "" {
+		return channel
+	}
+	return fmt.Sprintf("$share/%s/%s", group, channel)
+}
+
+// Deliver routes an incoming MQTT message to the subscription registered
+// for its topic. Wire it up as the MQTT client's publish handler.
+func (b *Bus) Deliver(p *paho.Publish) {
+	b.mu.Lock()
+	sub, ok := b.subs[p.Topic]
+	b.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	m := &Message{Payload: p.Payload}
+	if p.Properties != nil {
+		m.ResponseTopic = p.Properties.ResponseTopic
+		m.CorrelationID = string(p.Properties.CorrelationData)
+	}
+	sub.deliver(m)
+}
+
+// subscription is a psrpc.Subscription[*Message]. deliver and Close share
+// mu, so Close always observes and sets closed before deliver can decide
+// whether to send — there's no window where deliver sends on a channel
+// Close has already closed, and a consumer ranging over Channel() receives
+// whatever was buffered before Close and then terminates.
+type subscription struct {
+	channel string
+
+	mu     sync.Mutex
+	closed bool
+	msgs   chan *Message
+}
+
+func newSubscription(channel string) *subscription {
+	return &subscription{
+		channel: channel,
+		msgs:    make(chan *Message, subscriptionBuffer),
+	}
+}
+
+func (s *subscription) deliver(msg *Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.msgs <- msg:
+	default:
+		// Buffer full and nobody's draining fast enough; drop rather than
+		// block Deliver, which runs on the MQTT client's read loop.
+	}
+}
+
+func (s *subscription) Channel() <-chan *Message {
+	return s.msgs
+}
+
+func (s *subscription) ChannelSize() int {
+	return cap(s.msgs)
+}
+
+func (s *subscription) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	close(s.msgs)
+	return nil
+}
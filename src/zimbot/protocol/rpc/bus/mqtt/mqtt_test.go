@@ -0,0 +1,107 @@
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/eclipse/paho.golang/paho"
+)
+
+func TestSubscriptionFilter(t *testing.T) {
+	cases := []struct {
+		name    string
+		channel string
+		group   ShareGroup
+		want    string
+	}{
+		{"no group is a plain topic", "room-1", "", "room-1"},
+		{"group wraps the topic in a $share filter", "room-1", "workers", "$share/workers/room-1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := subscriptionFilter(c.channel, c.group); got != c.want {
+				t.Errorf("subscriptionFilter(%q, %q) = %q, want %q", c.channel, c.group, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDeliverRoutesByPlainTopic(t *testing.T) {
+	b := &Bus{subs: make(map[string]*subscription)}
+	sub := newSubscription("room-1")
+	b.subs["room-1"] = sub
+	defer sub.Close()
+
+	// A queue subscriber subscribes with a "$share/..." filter, but the
+	// broker always redelivers under the plain topic — Deliver must still
+	// find the subscription indexed under "room-1", not under the filter.
+	b.Deliver(&paho.Publish{
+		Topic:   "room-1",
+		Payload: []byte("payload"),
+		Properties: &paho.PublishProperties{
+			ResponseTopic:   "room-1/reply",
+			CorrelationData: []byte("corr-id"),
+		},
+	})
+
+	select {
+	case msg := <-sub.Channel():
+		if string(msg.Payload) != "payload" {
+			t.Errorf("Payload = %q, want %q", msg.Payload, "payload")
+		}
+		if msg.ResponseTopic != "room-1/reply" {
+			t.Errorf("ResponseTopic = %q, want %q", msg.ResponseTopic, "room-1/reply")
+		}
+		if msg.CorrelationID != "corr-id" {
+			t.Errorf("CorrelationID = %q, want %q", msg.CorrelationID, "corr-id")
+		}
+	default:
+		t.Fatal("expected message to be delivered to the matching subscription")
+	}
+}
+
+func TestDeliverIgnoresUnknownTopic(t *testing.T) {
+	b := &Bus{subs: make(map[string]*subscription)}
+	sub := newSubscription("room-1")
+	b.subs["room-1"] = sub
+	defer sub.Close()
+
+	b.Deliver(&paho.Publish{Topic: "room-2", Payload: []byte("payload")})
+
+	select {
+	case msg := <-sub.Channel():
+		t.Fatalf("unexpected delivery to room-1's subscription: %+v", msg)
+	default:
+	}
+}
+
+func TestSubscriptionCloseTerminatesRangeLoop(t *testing.T) {
+	sub := newSubscription("room-1")
+	sub.deliver(&Message{Payload: []byte("one")})
+	sub.Close()
+
+	received := 0
+	for range sub.Channel() {
+		received++
+	}
+	if received != 1 {
+		t.Errorf("received = %d, want 1 (the message delivered before Close)", received)
+	}
+}
+
+func TestSubscriptionDeliverAfterCloseIsNoop(t *testing.T) {
+	sub := newSubscription("room-1")
+	sub.Close()
+
+	// Must not panic (send on closed channel) and must not block.
+	sub.deliver(&Message{Payload: []byte("late")})
+}
+
+func TestSubscriptionChannelSize(t *testing.T) {
+	sub := newSubscription("room-1")
+	defer sub.Close()
+
+	if got := sub.ChannelSize(); got != subscriptionBuffer {
+		t.Errorf("ChannelSize() = %d, want %d", got, subscriptionBuffer)
+	}
+}